@@ -1,6 +1,8 @@
 use anyhow::Result;
 use pyo3::prelude::*;
-use pyo3::types::{PyTuple};
+use pyo3::types::{PyBytes, PyTuple};
+
+use std::collections::HashMap;
 
 use rust_core::paths::get_python_packages_path;
 
@@ -12,7 +14,9 @@ pub struct Controller {
 }
 
 impl Controller {
-    pub fn initialize_drivers() -> Result<Self> {
+    // `speed` is the global playback speed for scripts (1.0 = normal);
+    // run_script_at_speed can override it per script.
+    pub fn initialize_drivers(speed: f64) -> Result<Self> {
         Python::with_gil(|py| -> PyResult<Self> {
             // -------------------------------------------------
             // Configure Python path
@@ -31,7 +35,7 @@ impl Controller {
             // -------------------------------------------------
             // Call factory function
             // -------------------------------------------------
-            let result = lib.getattr("initialize_driver")?.call0()?;
+            let result = lib.getattr("initialize_driver")?.call1((speed,))?;
             let tuple = result.downcast::<PyTuple>()?;
 
             Ok(Self {
@@ -49,11 +53,17 @@ impl Controller {
     // =====================================================
 
     pub fn run_script(&self, script: &str) -> Result<()> {
+        self.run_script_at_speed(script, None)
+    }
+
+    // Same as run_script, but overrides the parser's playback speed for this script
+    // (2.0 = twice as fast, 0.5 = half speed).
+    pub fn run_script_at_speed(&self, script: &str, speed: Option<f64>) -> Result<()> {
         Python::with_gil(|py| {
             self.parser
                 .bind(py)
                 .getattr("parse")?
-                .call1((script,))?;
+                .call1((script, speed))?;
 
             self.keyboard.bind(py).getattr("execute")?.call0()?;
             self.mouse.bind(py).getattr("execute")?.call0()?;
@@ -76,12 +86,22 @@ impl Controller {
     // Low-level direct calls (optional)
     // =====================================================
 
-    pub fn mouse_move(&self, x: i32, y: i32) -> Result<()> {
+    // `duration` is in seconds (0 jumps instantly) and `easing` is one of
+    // linear / ease_in / ease_out / ease_in_out. `speed` (pixels per second)
+    // overrides `duration` with one derived from the distance travelled.
+    pub fn mouse_move(
+        &self,
+        x: i32,
+        y: i32,
+        duration: f64,
+        easing: &str,
+        speed: Option<f64>,
+    ) -> Result<()> {
         Python::with_gil(|py| {
             self.mouse
                 .bind(py)
                 .getattr("queue_move")?
-                .call1((x, y))?;
+                .call1((x, y, duration, easing, speed))?;
             Ok::<(), PyErr>(())
         })
         .map_err(Into::into)
@@ -126,6 +146,62 @@ impl Controller {
         .map_err(Into::into)
     }
 
+    // =====================================================
+    // Screen capture
+    // =====================================================
+
+    // Monitor geometries (left/top/width/height). Index 0 is the combined
+    // virtual screen, matching the `monitor_index` of capture_screen_jpeg.
+    pub fn monitors(&self) -> Result<Vec<HashMap<String, i32>>> {
+        Python::with_gil(|py| {
+            self.monitor
+                .bind(py)
+                .getattr("get_monitors")?
+                .call0()?
+                .extract::<Vec<HashMap<String, i32>>>()
+        })
+        .map_err(Into::into)
+    }
+
+    // Captures a monitor, or a (left, top, width, height) region relative to it,
+    // downscaled to `max_size` and encoded as JPEG at `quality` (1-95).
+    pub fn capture_screen_jpeg(
+        &self,
+        monitor_index: usize,
+        region: Option<(i32, i32, i32, i32)>,
+        max_size: Option<u32>,
+        quality: u8,
+    ) -> Result<Vec<u8>> {
+        Python::with_gil(|py| {
+            let monitor = self.monitor.bind(py);
+            let image = monitor
+                .getattr("capture_screen")?
+                .call1((monitor_index, region, max_size))?;
+
+            encode_jpeg(monitor, image, quality)
+        })
+        .map_err(Into::into)
+    }
+
+    // Captures the first window whose title contains `title`, encoded the same
+    // way as capture_screen_jpeg.
+    pub fn capture_window_jpeg(
+        &self,
+        title: &str,
+        max_size: Option<u32>,
+        quality: u8,
+    ) -> Result<Vec<u8>> {
+        Python::with_gil(|py| {
+            let monitor = self.monitor.bind(py);
+            let image = monitor
+                .getattr("capture_window")?
+                .call1((title, max_size))?;
+
+            encode_jpeg(monitor, image, quality)
+        })
+        .map_err(Into::into)
+    }
+
     // Expose DesktopMonitor class
     pub fn get_monitor(&self) -> &Monitor {
         &self.monitor
@@ -140,6 +216,20 @@ impl Controller {
     }
 }
 
+// Encodes a PIL image returned by DesktopMonitor as JPEG bytes.
+fn encode_jpeg(
+    monitor: &Bound<'_, PyAny>,
+    image: Bound<'_, PyAny>,
+    quality: u8,
+) -> PyResult<Vec<u8>> {
+    let bytes = monitor
+        .getattr("encode_image")?
+        .call1((image, quality))?
+        .downcast_into::<PyBytes>()?;
+
+    Ok(bytes.as_bytes().to_vec())
+}
+
 // // Example usage
 //
 // let controller = Controller::start()?;
@@ -19,27 +19,39 @@ pub enum NeuroInput {
     },
 }
 
-/// Message Neuro sends TO YOU
+/// Action Neuro chose
 #[derive(Debug)]
 pub struct NeuroAction {
     pub action: String,
     pub data: serde_json::Value,
 }
 
+/// Message Neuro sends TO YOU
+#[derive(Debug)]
+pub enum NeuroEvent {
+    Action(NeuroAction),
+    /// Anything this integration doesn't handle (e.g. commands from a newer
+    /// API version), passed on with the raw payload so callers can hook into it.
+    Unhandled {
+        command: Option<String>,
+        payload: String,
+    },
+}
+
 /// Starts the Neuro integration in the background
 ///
 /// Returns:
 /// - Sender: send context / results to Neuro
-/// - Receiver: receive actions from Neuro
+/// - Receiver: receive actions (and unhandled messages) from Neuro
 pub async fn start_integration(
     game_name: &str,
     ws_url: &str,
 ) -> (
     mpsc::Sender<NeuroInput>,
-    mpsc::Receiver<NeuroAction>,
+    mpsc::Receiver<NeuroEvent>,
 ) {
     let (to_neuro_tx, mut to_neuro_rx) = mpsc::channel::<NeuroInput>(32);
-    let (from_neuro_tx, from_neuro_rx) = mpsc::channel::<NeuroAction>(32);
+    let (from_neuro_tx, from_neuro_rx) = mpsc::channel::<NeuroEvent>(32);
 
     let game_name = game_name.to_string();
     let ws_url = ws_url.to_string();
@@ -73,14 +85,14 @@ pub async fn start_integration(
                 msg = read.next() => {
                     let Some(Ok(Message::Text(text))) = msg else { continue };
 
-                    if let Ok(parsed) = serde_json::from_str::<GameMessage>(&text) {
-                        if let GameMessage::Action { action, data } = parsed {
-                            let _ = from_neuro_tx.send(NeuroAction {
-                                action,
-                                data,
-                            }).await;
+                    let event = match serde_json::from_str::<GameMessage>(&text) {
+                        Ok(GameMessage::Action { action, data }) => {
+                            NeuroEvent::Action(NeuroAction { action, data })
                         }
-                    }
+                        _ => unhandled_event(text),
+                    };
+
+                    let _ = from_neuro_tx.send(event).await;
                 }
 
                 // Messages FROM your game
@@ -112,3 +124,18 @@ pub async fn start_integration(
 
     (to_neuro_tx, from_neuro_rx)
 }
+
+/// Logs a message the integration doesn't understand and wraps it for the caller.
+fn unhandled_event(payload: String) -> NeuroEvent {
+    let command = serde_json::from_str::<serde_json::Value>(&payload)
+        .ok()
+        .and_then(|value| value.get("command")?.as_str().map(str::to_string));
+
+    eprintln!(
+        "Unhandled Neuro message ({}): {}",
+        command.as_deref().unwrap_or("no command"),
+        payload
+    );
+
+    NeuroEvent::Unhandled { command, payload }
+}
@@ -3,13 +3,36 @@ use controller::Controller;
 
 mod integration;
 
-use integration::{start_integration, NeuroInput};
+use integration::{start_integration, NeuroEvent, NeuroInput};
+
+use std::env::VarError;
+
+const DEFAULT_GAME_NAME: &str = "Neuro's Desktop";
+
+/// Reads the game name from NEURO_GAME_NAME, so several instances (or forks)
+/// can share one Neuro server without colliding identities.
+fn game_name() -> String {
+    let name = match std::env::var("NEURO_GAME_NAME") {
+        Ok(name) => name,
+        Err(VarError::NotPresent) => DEFAULT_GAME_NAME.to_string(),
+        Err(VarError::NotUnicode(_)) => panic!("NEURO_GAME_NAME must be valid Unicode"),
+    };
+    let name = name.trim();
+
+    assert!(!name.is_empty(), "NEURO_GAME_NAME must not be empty");
+    assert!(
+        !name.chars().any(char::is_control),
+        "NEURO_GAME_NAME must not contain control characters"
+    );
+
+    name.to_string()
+}
 
 #[tokio::main]
 async fn main() {
-    // let controller = Controller::initialize_drivers().expect("Failed to start Controller Drivers");
+    // let controller = Controller::initialize_drivers(1.0).expect("Failed to start Controller Drivers");
 
-    // controller.mouse_move(400, 300).expect("Failed to move mouse");
+    // controller.mouse_move(400, 300, 0.3, "ease_out", None).expect("Failed to move mouse");
     // controller.mouse_click(400, 300).expect("Failed to click");
     // controller.type_text("Hello from Neuro 👋").expect("Failed to type text");
 
@@ -20,7 +43,7 @@ async fn main() {
     // Ok(())
 
     let (neuro_tx, mut neuro_rx) = start_integration(
-        "Neuro's Desktop",
+        &game_name(),
         "ws://localhost:8080/neuro",
     )
     .await;
@@ -35,7 +58,15 @@ async fn main() {
 
     // Game loop
     loop {
-        if let Some(action) = neuro_rx.recv().await {
+        if let Some(event) = neuro_rx.recv().await {
+            let action = match event {
+                NeuroEvent::Action(action) => action,
+                NeuroEvent::Unhandled { .. } => {
+                    // Hook for newer API commands; already logged by the integration.
+                    continue;
+                }
+            };
+
             println!("Neuro chose: {}", action.action);
 
             // YOU handle what this does
@@ -1,10 +1,25 @@
+import math
 import shlex
-from typing import List, Tuple
+from typing import List, Optional, Tuple
 
 from .controls.keyboard import KeyboardController
 from .controls.mouse import MouseController, Point
+from .controls.timing import (
+    KEY_DELAY,
+    MOVE_DURATION,
+    PATH_STEP_DURATION,
+    STEP_PAUSE,
+    TYPE_INTERVAL,
+)
 from .desktop import DesktopMonitor
 
+# Limits on untrusted scripts, so oversized input fails with a parse error
+# instead of building huge instruction queues.
+MAX_SCRIPT_LENGTH = 64 * 1024
+MAX_SCRIPT_LINES = 1000
+MAX_LINE_STEPS = 10_000
+MAX_PATH_VERTICES = 256  # draw_polyline expands each segment to 31 points
+
 
 class ActionParseError(Exception):
     pass
@@ -15,17 +30,39 @@ class ActionParser:
     Unified keyboard + mouse action parser.
     """
 
-    def __init__(self, keyboard: KeyboardController, mouse: MouseController, monitor: DesktopMonitor):
+    def __init__(
+        self,
+        keyboard: KeyboardController,
+        mouse: MouseController,
+        monitor: DesktopMonitor,
+        speed: float = 1.0,
+    ):
         self.kbd = keyboard
         self.mouse = mouse
         self.monitor = monitor
 
+        # Playback speed multiplier: 2.0 runs twice as fast, 0.5 at half speed.
+        self.speed = self._check_speed(speed)
+        self._active_speed = self.speed
+
     # ------------------------
     # Public API
     # ------------------------
 
-    def parse(self, script: str):
+    def parse(self, script: str, speed: Optional[float] = None):
+        """
+        Parses a script into the controller queues. `speed` overrides the
+        global playback speed for this script only.
+        """
+        self._active_speed = self._check_speed(speed) if speed is not None else self.speed
+
+        if len(script) > MAX_SCRIPT_LENGTH:
+            raise ActionParseError(
+                f"Script is {len(script)} characters long (limit {MAX_SCRIPT_LENGTH})"
+            )
         lines = script.strip().splitlines()
+        if len(lines) > MAX_SCRIPT_LINES:
+            raise ActionParseError(f"Script has {len(lines)} lines (limit {MAX_SCRIPT_LINES})")
 
         for line_no, raw_line in enumerate(lines, start=1):
             line = raw_line.strip()
@@ -40,6 +77,22 @@ class ActionParser:
                     f"Line {line_no}: {line}\n→ {e}"
                 ) from e
 
+    # ------------------------
+    # Playback speed
+    # ------------------------
+
+    @staticmethod
+    def _check_speed(speed: float) -> float:
+        if not (math.isfinite(speed) and speed > 0):
+            raise ValueError("Playback speed must be a finite number greater than 0")
+        return speed
+
+    def _scaled(self, seconds: float) -> float:
+        return seconds / self._active_speed
+
+    def _pause(self) -> float:
+        return self._scaled(STEP_PAUSE)
+
     # ------------------------
     # Line parser
     # ------------------------
@@ -62,7 +115,7 @@ class ActionParser:
             self._kbd_type(tokens)
 
         elif cmd == "ENTER":
-            self.kbd.enter()
+            self.kbd.enter(delay=self._scaled(KEY_DELAY), pause=self._pause())
 
         elif cmd == "PRESS":
             self._kbd_press(tokens)
@@ -110,59 +163,74 @@ class ActionParser:
         if len(tokens) < 2:
             raise ActionParseError("TYPE requires quoted text")
         text = " ".join(tokens[1:])
-        self.kbd.type(text)
+        self.kbd.type(text, interval=self._scaled(TYPE_INTERVAL), pause=self._pause())
 
     def _kbd_press(self, tokens: List[str]):
         if len(tokens) != 2:
             raise ActionParseError("PRESS key")
-        self.kbd.press(tokens[1])
+        self.kbd.press(tokens[1], delay=self._scaled(KEY_DELAY), pause=self._pause())
 
     def _kbd_hold(self, tokens: List[str]):
         if len(tokens) != 2:
             raise ActionParseError("HOLD key")
-        self.kbd.hold(tokens[1])
+        self.kbd.hold(tokens[1], pause=self._pause())
 
     def _kbd_release(self, tokens: List[str]):
         if len(tokens) != 2:
             raise ActionParseError("RELEASE key")
-        self.kbd.release(tokens[1])
+        self.kbd.release(tokens[1], pause=self._pause())
 
     def _kbd_shortcut(self, tokens: List[str]):
         if len(tokens) < 2:
             raise ActionParseError("SHORTCUT key1 key2 ...")
-        self.kbd.shortcut(*tokens[1:])
+        self.kbd.shortcut(*tokens[1:], pause=self._pause())
 
     # ========================
     # Mouse commands
     # ========================
 
     def _mouse_move(self, tokens: List[str]):
-        if len(tokens) not in (3, 4):
-            raise ActionParseError("MOVE x y [duration]")
+        usage = "MOVE x y [duration | SPEED px_per_sec] [easing]"
+        if len(tokens) < 3:
+            raise ActionParseError(usage)
         x, y = int(tokens[1]), int(tokens[2])
-        duration = float(tokens[3]) if len(tokens) == 4 else 0.1
-        self.mouse.queue_move(x, y, duration)
+        rest = tokens[3:]
+
+        duration, speed = MOVE_DURATION, None
+        if rest and rest[0].upper() == "SPEED":
+            if len(rest) < 2:
+                raise ActionParseError(usage)
+            speed = float(rest[1]) * self._active_speed
+            rest = rest[2:]
+        elif rest:
+            duration = float(rest[0])
+            rest = rest[1:]
+
+        if len(rest) > 1:
+            raise ActionParseError(usage)
+        easing = rest[0].lower() if rest else "linear"
+        self.mouse.queue_move(x, y, self._scaled(duration), easing, speed, self._pause())
 
     def _mouse_move_normalized(self, tokens: List[str]):
         if len(tokens) != 3:
             raise ActionParseError("MOVE_N nx ny")
         nx, ny = float(tokens[1]), float(tokens[2])
         x, y = self.mouse.map_normalized(nx, ny)
-        self.mouse.queue_move(x, y)
+        self.mouse.queue_move(x, y, self._scaled(MOVE_DURATION), pause=self._pause())
 
     def _mouse_click(self, tokens: List[str]):
         if len(tokens) not in (3, 4):
             raise ActionParseError("CLICK x y [button]")
         x, y = int(tokens[1]), int(tokens[2])
         button = tokens[3] if len(tokens) == 4 else "left"
-        self.mouse.queue_click(x, y, button)
+        self.mouse.queue_click(x, y, button, self._pause())
 
     def _mouse_click_normalized(self, tokens: List[str]):
         if len(tokens) != 3:
             raise ActionParseError("CLICK_N nx ny")
         nx, ny = float(tokens[1]), float(tokens[2])
         x, y = self.mouse.map_normalized(nx, ny)
-        self.mouse.queue_click(x, y)
+        self.mouse.queue_click(x, y, pause=self._pause())
 
     def _mouse_line(self, tokens: List[str]):
         if len(tokens) < 5:
@@ -173,15 +241,24 @@ class ActionParser:
 
         if "STEPS" in tokens:
             idx = tokens.index("STEPS")
+            if idx + 1 >= len(tokens):
+                raise ActionParseError("LINE x1 y1 x2 y2 [STEPS n]")
             steps = int(tokens[idx + 1])
+            if not 1 <= steps <= MAX_LINE_STEPS:
+                raise ActionParseError(f"STEPS must be between 1 and {MAX_LINE_STEPS}")
 
         path = self.mouse.draw_line((x1, y1), (x2, y2), steps)
-        self.mouse.queue_path(path)
+        self.mouse.queue_path(
+            path, step_duration=self._scaled(PATH_STEP_DURATION), pause=self._pause()
+        )
 
     def _mouse_path(self, tokens: List[str]):
         if (len(tokens) - 1) % 2 != 0:
             raise ActionParseError("PATH requires even number of coordinates")
 
+        if (len(tokens) - 1) // 2 > MAX_PATH_VERTICES:
+            raise ActionParseError(f"PATH supports at most {MAX_PATH_VERTICES} points")
+
         coords = list(map(int, tokens[1:]))
         points: List[Point] = [
             (coords[i], coords[i + 1])
@@ -189,7 +266,9 @@ class ActionParser:
         ]
 
         path = self.mouse.draw_polyline(points)
-        self.mouse.queue_path(path)
+        self.mouse.queue_path(
+            path, step_duration=self._scaled(PATH_STEP_DURATION), pause=self._pause()
+        )
 
     # ========================
     # Shared
@@ -198,6 +277,6 @@ class ActionParser:
     def _wait(self, tokens: List[str]):
         if len(tokens) != 2:
             raise ActionParseError("WAIT seconds")
-        seconds = float(tokens[1])
+        seconds = self._scaled(float(tokens[1]))
         self.kbd.wait(seconds)
         self.mouse.queue_wait(seconds)
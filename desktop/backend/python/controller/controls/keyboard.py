@@ -2,6 +2,7 @@ import time
 import pyautogui
 from typing import List, Union
 from ..desktop import DesktopMonitor
+from .timing import KEY_DELAY, STEP_PAUSE, TYPE_INTERVAL
 
 class KeyboardInstruction:
     def execute(self):
@@ -9,46 +10,55 @@ class KeyboardInstruction:
 
 
 class KeyTap(KeyboardInstruction):
-    def __init__(self, key: str, delay: float = 0.02):
+    def __init__(self, key: str, delay: float = KEY_DELAY, pause: float = STEP_PAUSE):
         self.key = key
         self.delay = delay
+        self.pause = pause
 
     def execute(self):
-        pyautogui.press(self.key)
-        time.sleep(self.delay)
+        pyautogui.press(self.key, _pause=False)
+        time.sleep(self.delay + self.pause)
 
 
 class KeyDown(KeyboardInstruction):
-    def __init__(self, key: str):
+    def __init__(self, key: str, pause: float = STEP_PAUSE):
         self.key = key
+        self.pause = pause
 
     def execute(self):
-        pyautogui.keyDown(self.key)
+        pyautogui.keyDown(self.key, _pause=False)
+        time.sleep(self.pause)
 
 
 class KeyUp(KeyboardInstruction):
-    def __init__(self, key: str):
+    def __init__(self, key: str, pause: float = STEP_PAUSE):
         self.key = key
+        self.pause = pause
 
     def execute(self):
-        pyautogui.keyUp(self.key)
+        pyautogui.keyUp(self.key, _pause=False)
+        time.sleep(self.pause)
 
 
 class TypeText(KeyboardInstruction):
-    def __init__(self, text: str, interval: float = 0.02):
+    def __init__(self, text: str, interval: float = TYPE_INTERVAL, pause: float = STEP_PAUSE):
         self.text = text
         self.interval = interval
+        self.pause = pause
 
     def execute(self):
-        pyautogui.write(self.text, interval=self.interval)
+        pyautogui.write(self.text, interval=self.interval, _pause=False)
+        time.sleep(self.pause)
 
 
 class Shortcut(KeyboardInstruction):
-    def __init__(self, *keys: str):
+    def __init__(self, *keys: str, pause: float = STEP_PAUSE):
         self.keys = keys
+        self.pause = pause
 
     def execute(self):
-        pyautogui.hotkey(*self.keys)
+        pyautogui.hotkey(*self.keys, _pause=False)
+        time.sleep(self.pause)
 
 
 class Wait(KeyboardInstruction):
@@ -76,45 +86,45 @@ class KeyboardController:
     # Intent-level API
     # ------------------------
 
-    def type(self, text: str, interval: float = 0.02):
+    def type(self, text: str, interval: float = TYPE_INTERVAL, pause: float = STEP_PAUSE):
         self.monitor.record_action(
             source="keyboard",
             action_type="TYPE",
             data={"text": text}
         )
-        self.queue.append(TypeText(text, interval))
+        self.queue.append(TypeText(text, interval, pause))
 
-    def press(self, key: str):
+    def press(self, key: str, delay: float = KEY_DELAY, pause: float = STEP_PAUSE):
         self.monitor.record_action(
             source="keyboard",
             action_type="PRESS",
             data={"key": key}
         )
-        self.queue.append(KeyTap(key))
+        self.queue.append(KeyTap(key, delay, pause))
 
-    def shortcut(self, *keys: str):
+    def shortcut(self, *keys: str, pause: float = STEP_PAUSE):
         self.monitor.record_action(
             source="keyboard",
             action_type="SHORTCUT",
             data={"keys": keys}
         )
-        self.queue.append(Shortcut(*keys))
+        self.queue.append(Shortcut(*keys, pause=pause))
 
-    def hold(self, key: str):
+    def hold(self, key: str, pause: float = STEP_PAUSE):
         self.monitor.record_action(
             source="keyboard",
             action_type="HOLD",
             data={"key": key}
         )
-        self.queue.append(KeyDown(key))
+        self.queue.append(KeyDown(key, pause))
 
-    def release(self, key: str):
+    def release(self, key: str, pause: float = STEP_PAUSE):
         self.monitor.record_action(
             source="keyboard",
             action_type="RELEASE",
             data={"key": key}
         )
-        self.queue.append(KeyUp(key))
+        self.queue.append(KeyUp(key, pause))
 
     def wait(self, seconds: float):
         self.monitor.record_action(
@@ -128,13 +138,13 @@ class KeyboardController:
     # Macro helpers
     # ------------------------
 
-    def enter(self):
+    def enter(self, delay: float = KEY_DELAY, pause: float = STEP_PAUSE):
         self.monitor.record_action(
             source="keyboard",
             action_type="ENTER",
             data={}
         )
-        self.press("enter")
+        self.press("enter", delay, pause)
 
     def backspace(self, times: int = 1):
         self.monitor.record_action(
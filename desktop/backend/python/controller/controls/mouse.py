@@ -1,10 +1,20 @@
+import math
 import pyautogui
 import time
-from typing import List, Tuple, Union
+from typing import List, Optional, Tuple, Union
 from ..desktop import DesktopMonitor
+from .timing import MOVE_DURATION, PATH_STEP_DURATION, STEP_PAUSE
 
 Point = Tuple[int, int]
 
+# Easing curves accepted by movement instructions.
+EASINGS = {
+    "linear": pyautogui.linear,
+    "ease_in": pyautogui.easeInQuad,
+    "ease_out": pyautogui.easeOutQuad,
+    "ease_in_out": pyautogui.easeInOutQuad,
+}
+
 
 class MouseInstruction:
     """Base class for mouse instructions."""
@@ -13,23 +23,45 @@ class MouseInstruction:
 
 
 class MoveInstruction(MouseInstruction):
-    def __init__(self, x: int, y: int, duration: float = 0.1):
+    def __init__(
+        self,
+        x: int,
+        y: int,
+        duration: float = MOVE_DURATION,
+        easing: str = "linear",
+        speed: Optional[float] = None,
+        pause: float = STEP_PAUSE,
+    ):
         self.x = x
         self.y = y
         self.duration = duration
+        self.easing = easing
+        self.speed = speed
+        self.pause = pause
 
     def execute(self):
-        pyautogui.moveTo(self.x, self.y, duration=self.duration)
+        duration = self.duration
+        if self.speed is not None:
+            # Speed is resolved here, since earlier queued moves change where the cursor starts.
+            cx, cy = pyautogui.position()
+            duration = math.hypot(self.x - cx, self.y - cy) / self.speed
+
+        pyautogui.moveTo(
+            self.x, self.y, duration=duration, tween=EASINGS[self.easing], _pause=False
+        )
+        time.sleep(self.pause)
 
 
 class ClickInstruction(MouseInstruction):
-    def __init__(self, x: int, y: int, button: str = "left"):
+    def __init__(self, x: int, y: int, button: str = "left", pause: float = STEP_PAUSE):
         self.x = x
         self.y = y
         self.button = button
+        self.pause = pause
 
     def execute(self):
-        pyautogui.click(self.x, self.y, button=self.button)
+        pyautogui.click(self.x, self.y, button=self.button, _pause=False)
+        time.sleep(self.pause)
 
 
 class WaitInstruction(MouseInstruction):
@@ -44,13 +76,22 @@ class PathInstruction(MouseInstruction):
     """
     Moves mouse through a sequence of points (a drawn line/path).
     """
-    def __init__(self, points: List[Point], step_duration: float = 0.02):
+    def __init__(
+        self,
+        points: List[Point],
+        step_duration: float = PATH_STEP_DURATION,
+        pause: float = STEP_PAUSE,
+    ):
         self.points = points
         self.step_duration = step_duration
+        self.pause = pause
 
     def execute(self):
+        # pyautogui jumps when the duration is at or below MINIMUM_DURATION (0.1s),
+        # so for short steps the pause after each point is what sets the drawing speed.
         for x, y in self.points:
-            pyautogui.moveTo(x, y, duration=self.step_duration)
+            pyautogui.moveTo(x, y, duration=self.step_duration, _pause=False)
+            time.sleep(self.pause)
 
 
 # -------------------------------------------------
@@ -88,23 +129,43 @@ class MouseController:
     # Instruction builders
     # ------------------------
 
-    def queue_move(self, x: int, y: int, duration: float = 0.1):
+    def queue_move(
+        self,
+        x: int,
+        y: int,
+        duration: float = MOVE_DURATION,
+        easing: str = "linear",
+        speed: Optional[float] = None,
+        pause: float = STEP_PAUSE,
+    ):
+        """
+        Queues a move. A duration of 0 jumps instantly; longer durations glide
+        along the given easing curve (see EASINGS). A speed in pixels per second
+        replaces the duration with one derived from the travelled distance.
+        """
+        if easing not in EASINGS:
+            raise ValueError(f"Unknown easing: {easing} (expected one of {', '.join(EASINGS)})")
+        if not math.isfinite(duration) or duration < 0:
+            raise ValueError("Move duration must be a non-negative number")
+        if speed is not None and not (math.isfinite(speed) and speed > 0):
+            raise ValueError("Move speed must be a positive number of pixels per second")
+
         self.monitor.record_action(
             source="mouse",
             action_type="MOVE",
-            data={"x": x, "y": y, "duration": duration}
+            data={"x": x, "y": y, "duration": duration, "easing": easing, "speed": speed}
         )
         x, y = self.clamp_point(x, y)
-        self.instruction_queue.append(MoveInstruction(x, y, duration))
+        self.instruction_queue.append(MoveInstruction(x, y, duration, easing, speed, pause))
 
-    def queue_click(self, x: int, y: int, button: str = "left"):
+    def queue_click(self, x: int, y: int, button: str = "left", pause: float = STEP_PAUSE):
         self.monitor.record_action(
             source="mouse",
             action_type="CLICK",
             data={"x": x, "y": y, "button": button}
         )
         x, y = self.clamp_point(x, y)
-        self.instruction_queue.append(ClickInstruction(x, y, button))
+        self.instruction_queue.append(ClickInstruction(x, y, button, pause))
 
     def queue_wait(self, duration: float):
         self.monitor.record_action(
@@ -114,14 +175,19 @@ class MouseController:
         )
         self.instruction_queue.append(WaitInstruction(duration))
 
-    def queue_path(self, points: List[Point], step_duration: float = 0.02):
+    def queue_path(
+        self,
+        points: List[Point],
+        step_duration: float = PATH_STEP_DURATION,
+        pause: float = STEP_PAUSE,
+    ):
         self.monitor.record_action(
             source="mouse",
             action_type="PATH",
             data={"points": points, "step_duration": step_duration}
         )
         clamped = [self.clamp_point(x, y) for x, y in points]
-        self.instruction_queue.append(PathInstruction(clamped, step_duration))
+        self.instruction_queue.append(PathInstruction(clamped, step_duration, pause))
 
     # ------------------------
     # Drawing helpers (AI-friendly)
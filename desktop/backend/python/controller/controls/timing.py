@@ -0,0 +1,12 @@
+# Default timings shared by the controllers and the script parser.
+# The parser scales all of them by the playback speed.
+
+# pyautogui sleeps PAUSE (0.1s) after every call. Instructions disable that
+# and sleep STEP_PAUSE themselves, so the pause can be scaled like any other delay.
+STEP_PAUSE = 0.1
+
+KEY_DELAY = 0.02
+TYPE_INTERVAL = 0.02
+
+MOVE_DURATION = 0.1
+PATH_STEP_DURATION = 0.02
@@ -1,3 +1,4 @@
+import io
 import time
 import threading
 from typing import List, Tuple, Optional, Dict, Any
@@ -139,11 +140,105 @@ class DesktopMonitor:
     def get_screen_size(self) -> Tuple[int, int]:
         return pyautogui.size()
 
-    def capture_screen(self) -> Image.Image:
+    def get_monitors(self) -> List[Dict[str, int]]:
+        """
+        Lists monitor geometries. Index 0 is the combined virtual screen,
+        1..n are the individual monitors (same indexing as capture_screen).
+        """
+        with mss.mss() as sct:
+            return [dict(m) for m in sct.monitors]
+
+    def capture_screen(
+        self,
+        monitor_index: int = 1,
+        region: Optional[Tuple[int, int, int, int]] = None,
+        max_size: Optional[int] = None,
+    ) -> Image.Image:
+        """
+        Captures a monitor, or a (left, top, width, height) region relative to it.
+        With max_size set, the image is downscaled so its longest side fits.
+        """
+        if max_size is not None and max_size <= 0:
+            raise ValueError("max_size must be positive")
+
         with mss.mss() as sct:
-            monitor = sct.monitors[1]
+            if not 0 <= monitor_index < len(sct.monitors):
+                raise ValueError(
+                    f"Monitor {monitor_index} does not exist (0-{len(sct.monitors) - 1})"
+                )
+            monitor = sct.monitors[monitor_index]
+
+            if region is not None:
+                left, top, width, height = region
+                if width <= 0 or height <= 0:
+                    raise ValueError("Region width and height must be positive")
+                if (
+                    left < 0
+                    or top < 0
+                    or left + width > monitor["width"]
+                    or top + height > monitor["height"]
+                ):
+                    raise ValueError(
+                        f"Region {region} is outside monitor {monitor_index} "
+                        f"({monitor['width']}x{monitor['height']})"
+                    )
+                monitor = {
+                    "left": monitor["left"] + left,
+                    "top": monitor["top"] + top,
+                    "width": width,
+                    "height": height,
+                }
+
             screenshot = sct.grab(monitor)
-            return Image.frombytes("RGB", screenshot.size, screenshot.rgb)
+            img = Image.frombytes("RGB", screenshot.size, screenshot.rgb)
+
+        if max_size is not None:
+            img.thumbnail((max_size, max_size), Image.LANCZOS)
+        return img
+
+    def capture_window(self, title: str, max_size: Optional[int] = None) -> Image.Image:
+        """
+        Captures the first window whose title contains `title`.
+        Parts of the window covered by other windows are captured as they appear on screen.
+        """
+        if not title or not title.strip():
+            raise ValueError("Window title must not be empty")
+
+        # Backend errors (e.g. an unsupported platform) propagate as-is so they
+        # are not mistaken for a missing window.
+        windows = [w for w in gw.getWindowsWithTitle(title) if w.title]
+        if not windows:
+            raise ValueError(f"No window titled {title!r}")
+
+        win = windows[0]
+        if getattr(win, "isMinimized", False):
+            raise ValueError(f"Window {win.title!r} is minimized")
+
+        with mss.mss() as sct:
+            virtual = sct.monitors[0]
+
+        # Clip to the virtual screen: maximized windows overhang the edges by a
+        # few pixels, and windows can be dragged partly off-screen.
+        left = max(win.left, virtual["left"])
+        top = max(win.top, virtual["top"])
+        right = min(win.left + win.width, virtual["left"] + virtual["width"])
+        bottom = min(win.top + win.height, virtual["top"] + virtual["height"])
+        if right <= left or bottom <= top:
+            raise ValueError(f"Window {win.title!r} is not on screen")
+
+        region = (left - virtual["left"], top - virtual["top"], right - left, bottom - top)
+        return self.capture_screen(0, region, max_size)
+
+    def encode_image(self, img: Image.Image, quality: int = 75) -> bytes:
+        """
+        Encodes a capture as JPEG, keeping large screenshots small enough to send as context.
+        """
+        if not 1 <= quality <= 95:
+            raise ValueError("JPEG quality must be between 1 and 95")
+
+        buffer = io.BytesIO()
+        img.save(buffer, format="JPEG", quality=quality)
+        return buffer.getvalue()
 
     # =================================================
     # System info
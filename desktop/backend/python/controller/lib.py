@@ -4,9 +4,9 @@ from .controls.keyboard import KeyboardController
 from .actions import ActionParser
 from .desktop import DesktopMonitor
 
-def initialize_driver():
+def initialize_driver(speed: float = 1.0):
     monitor = DesktopMonitor()
     mouse = MouseController(monitor)
     keyboard = KeyboardController(monitor)
-    parser = ActionParser(keyboard, mouse, monitor)
+    parser = ActionParser(keyboard, mouse, monitor, speed)
     return monitor, mouse, keyboard, parser
\ No newline at end of file
@@ -1,3 +0,0 @@
-module neuro/go
-
-go 1.25.3
@@ -0,0 +1,106 @@
+package neuro
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"unicode/utf8"
+)
+
+// Size limits applied to untrusted input from the websocket.
+const (
+	MaxMessageSize    = 1 << 20
+	MaxActionDataSize = 64 << 10
+)
+
+// Errors returned by the decode functions. Callers can match them with errors.Is
+// and turn them into a failed action result instead of dropping the message.
+var (
+	ErrTooLarge      = errors.New("neuro: payload too large")
+	ErrInvalidUTF8   = errors.New("neuro: payload is not valid UTF-8")
+	ErrMalformed     = errors.New("neuro: malformed payload")
+	ErrWrongCommand  = errors.New("neuro: unexpected command")
+	ErrMissingData   = errors.New("neuro: action has no data")
+	ErrMissingAction = errors.New("neuro: action is missing id or name")
+)
+
+// DecodeMessage parses a raw websocket frame into a Message.
+func DecodeMessage(raw []byte) (Message, error) {
+	if err := checkPayload(raw, MaxMessageSize); err != nil {
+		return Message{}, err
+	}
+
+	var msg Message
+	if err := decodeJSON(raw, &msg, false); err != nil {
+		return Message{}, err
+	}
+	if msg.Command == "" {
+		return Message{}, fmt.Errorf("%w: missing command", ErrMalformed)
+	}
+	return msg, nil
+}
+
+// DecodeAction extracts the IncomingAction from an "action" message.
+func DecodeAction(msg Message) (IncomingAction, error) {
+	if msg.Command != CommandAction {
+		return IncomingAction{}, fmt.Errorf("%w: %q", ErrWrongCommand, msg.Command)
+	}
+	if len(msg.Data) == 0 {
+		return IncomingAction{}, fmt.Errorf("%w: missing data", ErrMalformed)
+	}
+
+	var action IncomingAction
+	if err := decodeJSON(msg.Data, &action, false); err != nil {
+		return IncomingAction{}, err
+	}
+	if action.ID == "" || action.Name == "" {
+		return IncomingAction{}, ErrMissingAction
+	}
+	// encoding/json already replaced invalid UTF-8 with U+FFFD, so only the size needs checking.
+	if len(action.Data) > MaxActionDataSize {
+		return IncomingAction{}, fmt.Errorf("%w: %d bytes (limit %d)", ErrTooLarge, len(action.Data), MaxActionDataSize)
+	}
+	return action, nil
+}
+
+// DecodeData unmarshals the action's JSON encoded Data string into v.
+// Unknown fields and trailing content are rejected so typos in parameters
+// fail loudly instead of being silently ignored.
+func (a IncomingAction) DecodeData(v any) error {
+	if a.Data == "" {
+		return ErrMissingData
+	}
+	if err := checkPayload([]byte(a.Data), MaxActionDataSize); err != nil {
+		return err
+	}
+	return decodeJSON([]byte(a.Data), v, true)
+}
+
+func checkPayload(raw []byte, limit int) error {
+	if len(raw) > limit {
+		return fmt.Errorf("%w: %d bytes (limit %d)", ErrTooLarge, len(raw), limit)
+	}
+	if !utf8.Valid(raw) {
+		return ErrInvalidUTF8
+	}
+	return nil
+}
+
+// decodeJSON decodes exactly one JSON value from raw. Envelopes are decoded
+// with strict off so newer server fields do not break older clients.
+func decodeJSON(raw []byte, v any, strict bool) error {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	if strict {
+		dec.DisallowUnknownFields()
+	}
+
+	if err := dec.Decode(v); err != nil {
+		return fmt.Errorf("%w: %v", ErrMalformed, err)
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return fmt.Errorf("%w: trailing data", ErrMalformed)
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package neuro
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestDecodeMessage(t *testing.T) {
+	cases := []struct {
+		name string
+		raw  string
+		err  error
+	}{
+		{"action", `{"command":"action","data":{"id":"1","name":"wait"}}`, nil},
+		{"unknown fields allowed", `{"command":"action","extra":1}`, nil},
+		{"missing command", `{"data":{}}`, ErrMalformed},
+		{"not json", `command: action`, ErrMalformed},
+		{"trailing data", `{"command":"action"} {}`, ErrMalformed},
+		{"invalid utf8", "{\"command\":\"\xff\"}", ErrInvalidUTF8},
+		{"too large", `{"command":"` + strings.Repeat("a", MaxMessageSize) + `"}`, ErrTooLarge},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := DecodeMessage([]byte(tc.raw))
+			if !errors.Is(err, tc.err) {
+				t.Errorf("err = %v, want %v", err, tc.err)
+			}
+		})
+	}
+}
+
+func TestDecodeAction(t *testing.T) {
+	cases := []struct {
+		name string
+		msg  Message
+		err  error
+	}{
+		{"valid", Message{Command: CommandAction, Data: []byte(`{"id":"1","name":"wait","data":"{}"}`)}, nil},
+		{"wrong command", Message{Command: CommandContext, Data: []byte(`{}`)}, ErrWrongCommand},
+		{"no data", Message{Command: CommandAction}, ErrMalformed},
+		{"missing id", Message{Command: CommandAction, Data: []byte(`{"name":"wait"}`)}, ErrMissingAction},
+		{"object data", Message{Command: CommandAction, Data: []byte(`{"id":"1","name":"wait","data":{}}`)}, ErrMalformed},
+		{"oversized data", Message{Command: CommandAction, Data: []byte(`{"id":"1","name":"wait","data":"` + strings.Repeat("a", MaxActionDataSize+1) + `"}`)}, ErrTooLarge},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := DecodeAction(tc.msg)
+			if !errors.Is(err, tc.err) {
+				t.Errorf("err = %v, want %v", err, tc.err)
+			}
+		})
+	}
+}
+
+func TestDecodeData(t *testing.T) {
+	type params struct {
+		Text string `json:"text"`
+	}
+
+	var p params
+	if err := (IncomingAction{Data: `{"text":"hi"}`}).DecodeData(&p); err != nil {
+		t.Fatal(err)
+	}
+	if p.Text != "hi" {
+		t.Errorf("text = %q, want %q", p.Text, "hi")
+	}
+
+	cases := []struct {
+		name string
+		data string
+		err  error
+	}{
+		{"empty", ``, ErrMissingData},
+		{"unknown field", `{"txt":"hi"}`, ErrMalformed},
+		{"double encoded", `"{\"text\":\"hi\"}"`, ErrMalformed},
+		{"trailing data", `{"text":"hi"}x`, ErrMalformed},
+		{"invalid utf8", "{\"text\":\"\xff\"}", ErrInvalidUTF8},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := (IncomingAction{Data: tc.data}).DecodeData(&params{})
+			if !errors.Is(err, tc.err) {
+				t.Errorf("err = %v, want %v", err, tc.err)
+			}
+		})
+	}
+}
+
+func FuzzDecodeMessage(f *testing.F) {
+	f.Add([]byte(`{"command":"action","data":{"id":"1","name":"type_text","data":"{\"text\":\"hi\"}"}}`))
+	f.Add([]byte(`{"command":"action","data":null}`))
+	f.Add([]byte(`{"command":""}`))
+	f.Add([]byte(`[]`))
+
+	f.Fuzz(func(t *testing.T, raw []byte) {
+		msg, err := DecodeMessage(raw)
+		if err != nil {
+			return
+		}
+		if msg.Command == "" {
+			t.Fatal("decoded message without a command")
+		}
+
+		action, err := DecodeAction(msg)
+		if err != nil {
+			return
+		}
+		if action.ID == "" || action.Name == "" {
+			t.Fatalf("decoded action without id or name: %+v", action)
+		}
+
+		var data map[string]any
+		_ = action.DecodeData(&data)
+	})
+}
+
+func FuzzDecodeData(f *testing.F) {
+	f.Add(`{"text":"hi"}`)
+	f.Add(`{"x":1,"y":2}`)
+	f.Add(`"{}"`)
+
+	f.Fuzz(func(t *testing.T, data string) {
+		var v any
+		_ = (IncomingAction{Data: data}).DecodeData(&v)
+	})
+}
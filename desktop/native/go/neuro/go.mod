@@ -0,0 +1,3 @@
+module github.com/cassitly/neuro-desktop/desktop/native/go/neuro
+
+go 1.25.3
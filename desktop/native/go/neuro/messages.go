@@ -0,0 +1,91 @@
+package neuro
+
+import "encoding/json"
+
+// Commands sent by the game to Neuro.
+const (
+	CommandStartup           = "startup"
+	CommandContext           = "context"
+	CommandRegisterActions   = "actions/register"
+	CommandUnregisterActions = "actions/unregister"
+	CommandForceActions      = "actions/force"
+	CommandActionResult      = "action/result"
+)
+
+// Commands sent by Neuro to the game.
+const (
+	CommandAction = "action"
+)
+
+// Message is the envelope shared by every websocket message in both directions.
+// Data is kept raw so it can be decoded into the type matching Command.
+type Message struct {
+	Command string          `json:"command"`
+	Game    string          `json:"game,omitempty"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+// ActionDefinition describes an action Neuro is allowed to call.
+// Schema is a JSON schema for the action's data; nil means the action takes no data.
+type ActionDefinition struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	Schema      map[string]any `json:"schema,omitempty"`
+}
+
+// IncomingAction is the data of an "action" message.
+// Data holds the action parameters as a JSON encoded string, as sent by Neuro.
+type IncomingAction struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Data string `json:"data,omitempty"`
+}
+
+// ContextData is the data of a "context" message.
+type ContextData struct {
+	Message string `json:"message"`
+	Silent  bool   `json:"silent"`
+}
+
+// RegisterActionsData is the data of an "actions/register" message.
+type RegisterActionsData struct {
+	Actions []ActionDefinition `json:"actions"`
+}
+
+// UnregisterActionsData is the data of an "actions/unregister" message.
+type UnregisterActionsData struct {
+	ActionNames []string `json:"action_names"`
+}
+
+// ForceActionsData is the data of an "actions/force" message.
+// Neuro must pick one of ActionNames as soon as possible. State and Query describe
+// the situation; with EphemeralContext set they are forgotten after the choice.
+type ForceActionsData struct {
+	State            string   `json:"state,omitempty"`
+	Query            string   `json:"query"`
+	EphemeralContext bool     `json:"ephemeral_context,omitempty"`
+	ActionNames      []string `json:"action_names"`
+}
+
+// ActionResultData is the data of an "action/result" message.
+type ActionResultData struct {
+	ID      string `json:"id"`
+	Success bool   `json:"success"`
+	Message string `json:"message,omitempty"`
+}
+
+// NewMessage builds a message for game with data marshaled into the envelope.
+// A nil data produces a message without a data field.
+func NewMessage(command, game string, data any) (Message, error) {
+	msg := Message{Command: command, Game: game}
+	if data == nil {
+		return msg, nil
+	}
+
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return Message{}, err
+	}
+	msg.Data = raw
+	return msg, nil
+}
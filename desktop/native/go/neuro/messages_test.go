@@ -0,0 +1,111 @@
+package neuro
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func roundTrip[T any](t *testing.T, in T) T {
+	t.Helper()
+
+	raw, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("marshal %T: %v", in, err)
+	}
+
+	var out T
+	if err := json.Unmarshal(raw, &out); err != nil {
+		t.Fatalf("unmarshal %T from %s: %v", in, raw, err)
+	}
+	return out
+}
+
+func checkRoundTrip[T any](t *testing.T, in T) {
+	t.Helper()
+
+	if out := roundTrip(t, in); !reflect.DeepEqual(in, out) {
+		t.Errorf("round trip of %T: got %+v, want %+v", in, out, in)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	checkRoundTrip(t, ContextData{Message: "window changed", Silent: true})
+	checkRoundTrip(t, RegisterActionsData{Actions: []ActionDefinition{
+		{Name: "wait", Description: "Do nothing"},
+		{Name: "type_text", Description: "Type text", Schema: map[string]any{
+			"type":     "object",
+			"required": []any{"text"},
+		}},
+	}})
+	checkRoundTrip(t, UnregisterActionsData{ActionNames: []string{"wait", "type_text"}})
+	checkRoundTrip(t, ForceActionsData{
+		State:            "A save dialog is open",
+		Query:            "Save the document?",
+		EphemeralContext: true,
+		ActionNames:      []string{"click", "key_press"},
+	})
+	checkRoundTrip(t, ActionResultData{ID: "1", Success: false, Message: "bad params"})
+	checkRoundTrip(t, IncomingAction{ID: "1", Name: "type_text", Data: `{"text":"hi"}`})
+}
+
+func TestNewMessage(t *testing.T) {
+	msg, err := NewMessage(CommandContext, "Neuro Desktop", ContextData{Message: "hello"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	raw, err := json.Marshal(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"command":"context","game":"Neuro Desktop","data":{"message":"hello","silent":false}}`
+	if string(raw) != want {
+		t.Errorf("got %s, want %s", raw, want)
+	}
+
+	out := roundTrip(t, msg)
+	var data ContextData
+	if err := json.Unmarshal(out.Data, &data); err != nil {
+		t.Fatal(err)
+	}
+	if data.Message != "hello" {
+		t.Errorf("data message = %q, want %q", data.Message, "hello")
+	}
+}
+
+func TestNewMessageWithoutData(t *testing.T) {
+	msg, err := NewMessage(CommandStartup, "Neuro Desktop", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	raw, err := json.Marshal(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"command":"startup","game":"Neuro Desktop"}`; string(raw) != want {
+		t.Errorf("got %s, want %s", raw, want)
+	}
+}
+
+func TestDecodeIncomingAction(t *testing.T) {
+	raw := `{"command":"action","data":{"id":"abc","name":"type_text","data":"{\"text\":\"hi\"}"}}`
+
+	var msg Message
+	if err := json.Unmarshal([]byte(raw), &msg); err != nil {
+		t.Fatal(err)
+	}
+	if msg.Command != CommandAction {
+		t.Fatalf("command = %q, want %q", msg.Command, CommandAction)
+	}
+
+	var action IncomingAction
+	if err := json.Unmarshal(msg.Data, &action); err != nil {
+		t.Fatal(err)
+	}
+	want := IncomingAction{ID: "abc", Name: "type_text", Data: `{"text":"hi"}`}
+	if action != want {
+		t.Errorf("got %+v, want %+v", action, want)
+	}
+}
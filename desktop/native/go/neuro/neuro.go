@@ -0,0 +1,38 @@
+// Package neuro is the Go SDK for talking to the Neuro game API.
+//
+// The SDK lives in its own module so other projects can import it directly.
+// Releases are tagged with the module path prefix, e.g. desktop/native/go/neuro/v0.1.0.
+package neuro
+
+import "runtime/debug"
+
+const modulePath = "github.com/cassitly/neuro-desktop/desktop/native/go/neuro"
+
+// Version reports which release of this module the running binary was built with,
+// as recorded by the go command (e.g. "v0.1.0"). Builds from a local checkout,
+// workspace or replace directive report "(devel)".
+func Version() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "(unknown)"
+	}
+
+	version := ""
+	if info.Main.Path == modulePath {
+		version = info.Main.Version
+	}
+	for _, dep := range info.Deps {
+		if dep.Path != modulePath {
+			continue
+		}
+		version = dep.Version
+		if dep.Replace != nil {
+			version = dep.Replace.Version
+		}
+	}
+
+	if version == "" {
+		return "(devel)"
+	}
+	return version
+}
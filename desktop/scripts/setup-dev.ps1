@@ -26,7 +26,7 @@ cargo --version | Out-Null
 if (Test-Path "native/go") {
     Write-Host "Setting up Go..."
     cd native/go
-    go mod tidy
+    go work sync
     cd ../..
 }
 